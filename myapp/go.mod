@@ -12,5 +12,6 @@ require (
 require (
 	github.com/CloudyKit/fastprinter v0.0.0-20200109182630-33d98a066a53 // indirect
 	github.com/CloudyKit/jet/v6 v6.2.0 // indirect
+	github.com/andybalholm/brotli v1.2.6 // indirect
 	github.com/joho/godotenv v1.5.1 // indirect
 )
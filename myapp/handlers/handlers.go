@@ -10,9 +10,6 @@ type Handlers struct {
 	App *celeritas.Celeritas
 }
 
-func (h *Handlers) Home(w http.ResponseWriter, r *http.Request) {
-	err := h.App.Render.Page(w, r, "home", nil, nil)
-	if err != nil {
-		h.App.ErrorLog.Println("error rendering:", err)
-	}
+func (h *Handlers) Home(w http.ResponseWriter, r *http.Request) error {
+	return h.App.Render.Page(w, r, "home", nil, nil)
 }
@@ -29,6 +29,12 @@ func initApplication() *application {
 
 	cel.AppName = "myapp"
 
+	// bootstrap is downloaded into public/vendor by make bootstrap rather than committed
+	cel.Assets.Required = []string{"vendor/bootstrap/css/bootstrap.min.css"}
+	if err := cel.Assets.Check(); err != nil {
+		cel.ErrorLog.Println(err, "- run make bootstrap")
+	}
+
 	cel.InfoLog.Println("Debug is set to", cel.Debug)
 
 	// handlers
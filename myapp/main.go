@@ -1,6 +1,8 @@
 package main
 
 import (
+	"os"
+
 	"github.com/polyglotdev/celeritas"
 
 	"github.com/polyglotdev/myapp/handlers"
@@ -13,5 +15,14 @@ type application struct {
 
 func main() {
 	c := initApplication()
+
+	// run a command such as `down` or `up` instead of serving, if one was given
+	if ran, err := c.App.RunCommand(os.Args[1:]); ran {
+		if err != nil {
+			c.App.ErrorLog.Fatal(err)
+		}
+		return
+	}
+
 	c.App.ListenAndServe()
 }
@@ -0,0 +1,13 @@
+create table tokens
+(
+    id           int unsigned auto_increment primary key,
+    user_id      int unsigned not null,
+    name         varchar(255) not null,
+    token_hash   varbinary(32) not null,
+    abilities    text         not null,
+    last_used_at timestamp    null,
+    expires_at   timestamp    null,
+    created_at   timestamp    not null default current_timestamp,
+    unique key tokens_token_hash_idx (token_hash),
+    key tokens_user_id_idx (user_id)
+);
@@ -0,0 +1,14 @@
+create table tokens
+(
+    id           serial primary key,
+    user_id      integer      not null,
+    name         varchar(255) not null,
+    token_hash   bytea        not null,
+    abilities    text         not null default '',
+    last_used_at timestamp,
+    expires_at   timestamp,
+    created_at   timestamp    not null default now()
+);
+
+create unique index tokens_token_hash_idx on tokens (token_hash);
+create index tokens_user_id_idx on tokens (user_id);
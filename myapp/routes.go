@@ -10,14 +10,22 @@ func (a *application) routes() *chi.Mux {
 	// middleware must come before routes
 
 	//  add routes
-	a.App.Routes.Get("/", a.Handlers.Home)
-	a.App.Routes.Get("/jet", func(w http.ResponseWriter, r *http.Request) {
-		a.App.Render.JetPage(w, r, "testjet", nil, nil)
+	a.App.Get("/", a.Handlers.Home).Name("home")
+	a.App.Get("/jet", func(w http.ResponseWriter, r *http.Request) error {
+		return a.App.Render.JetPage(w, r, "testjet", nil, nil)
+	}).Name("jet")
+
+	// api routes, authenticated with bearer tokens
+	a.App.Routes.Route("/api", func(r chi.Router) {
+		r.Use(a.App.CORS())
+		r.Use(a.App.Tokens.Authenticated)
+		r.Delete("/tokens/current", a.App.Tokens.RevokeHandler)
 	})
+	a.App.Name("tokens.revoke", "/api/tokens/current")
 
-	// static routes
-	fileSever := http.FileServer(http.Dir("./public"))
-	a.App.Routes.Handle("/public/*", http.StripPrefix("/public", fileSever))
+	// static routes; fingerprinted assets are cached for good
+	a.App.Routes.Handle("/public/build/*", http.StripPrefix("/public/build", a.App.Assets.Handler()))
+	a.App.Static("/public", "public")
 
 	return a.App.Routes
 }